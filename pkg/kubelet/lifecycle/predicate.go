@@ -21,10 +21,12 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apiserver/pkg/util/feature"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 	v1affinityhelper "k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 	"k8s.io/klog/v2"
 	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
 	"k8s.io/kubernetes/pkg/features"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
 	schedulerframework "k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeaffinity"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodename"
@@ -95,6 +97,26 @@ func (w *predicateAdmitHandler) Admit(attrs *PodAdmitAttributes) PodAdmitResult
 	// the Resource Class API in the future.
 	podWithoutMissingExtendedResources := removeMissingExtendedResources(admitPod, nodeInfo)
 
+	// Reject pods that do not tolerate the node's NoExecute taints. Only
+	// NoExecute is enforced here: scheduling effects are the scheduler's
+	// business, and the taint manager would immediately evict such a pod
+	// anyway if it were admitted. Critical pods (including static pods,
+	// which never pass API admission and so carry no default tolerations)
+	// are exempt, like they are from taint-based eviction.
+	if !kubelettypes.IsCriticalPod(admitPod) {
+		if taint, isUntolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, admitPod.Spec.Tolerations, func(t *v1.Taint) bool {
+			return t.Effect == v1.TaintEffectNoExecute
+		}); isUntolerated {
+			message := fmt.Sprintf("Pod does not tolerate node taint %s", taint.ToString())
+			klog.V(2).InfoS("Failed to admit pod: pod does not tolerate node taint", "pod", klog.KObj(admitPod), "taint", taint.ToString())
+			return PodAdmitResult{
+				Admit:   false,
+				Reason:  "UntoleratedTaint",
+				Message: message,
+			}
+		}
+	}
+
 	reasons, err := GeneralPredicates(podWithoutMissingExtendedResources, nodeInfo)
 	fit := len(reasons) == 0 && err == nil
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
+	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
 	schedulerframework "k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodename"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeports"
@@ -267,3 +268,82 @@ func TestGeneralPredicates(t *testing.T) {
 		})
 	}
 }
+
+type fakeAdmissionFailureHandler struct{}
+
+func (f *fakeAdmissionFailureHandler) HandleAdmissionFailure(admitPod *v1.Pod, failureReasons []PredicateFailureReason) ([]PredicateFailureReason, error) {
+	return failureReasons, nil
+}
+
+func TestAdmitTaintedNode(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine"},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{
+				{Key: "unhealthy", Effect: v1.TaintEffectNoExecute},
+				{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule},
+			},
+		},
+		Status: v1.NodeStatus{
+			Capacity:    v1.ResourceList{v1.ResourcePods: *resource.NewQuantity(40, resource.DecimalSI)},
+			Allocatable: v1.ResourceList{v1.ResourcePods: *resource.NewQuantity(40, resource.DecimalSI)},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		tolerations []v1.Toleration
+		staticPod   bool
+		wantAdmit   bool
+		wantReason  string
+	}{
+		{
+			name:       "pod not tolerating a NoExecute taint is rejected",
+			wantAdmit:  false,
+			wantReason: "UntoleratedTaint",
+		},
+		{
+			name: "pod tolerating the NoExecute taint is admitted despite the NoSchedule taint",
+			tolerations: []v1.Toleration{
+				{Key: "unhealthy", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+			},
+			wantAdmit: true,
+		},
+		{
+			name:      "static pod is exempt from the taint check",
+			staticPod: true,
+			wantAdmit: true,
+		},
+		{
+			name: "wildcard toleration admits",
+			tolerations: []v1.Toleration{
+				{Operator: v1.TolerationOpExists},
+			},
+			wantAdmit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewPredicateAdmitHandler(
+				func() (*v1.Node, error) { return node, nil },
+				&fakeAdmissionFailureHandler{},
+				func(nodeInfo *schedulerframework.NodeInfo, attrs *PodAdmitAttributes) error { return nil },
+			)
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+				Spec:       v1.PodSpec{NodeName: "machine", Tolerations: test.tolerations},
+			}
+			if test.staticPod {
+				pod.Annotations = map[string]string{kubelettypes.ConfigSourceAnnotationKey: kubelettypes.FileSource}
+			}
+			result := handler.Admit(&PodAdmitAttributes{Pod: pod})
+			if result.Admit != test.wantAdmit {
+				t.Fatalf("expected Admit=%v, got %v (reason: %s, message: %s)", test.wantAdmit, result.Admit, result.Reason, result.Message)
+			}
+			if !test.wantAdmit && result.Reason != test.wantReason {
+				t.Errorf("expected reason %q, got %q", test.wantReason, result.Reason)
+			}
+		})
+	}
+}
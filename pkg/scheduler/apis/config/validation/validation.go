@@ -183,6 +183,25 @@ func validateKubeSchedulerProfile(path *field.Path, apiVersion string, profile *
 		errs = append(errs, field.Required(path.Child("schedulerName"), ""))
 	}
 	errs = append(errs, validatePluginConfig(path, apiVersion, profile)...)
+	errs = append(errs, validateScorePluginWeights(path, profile)...)
+	return errs
+}
+
+// validateScorePluginWeights checks that the configured score plugin weights
+// are not negative. A weight of zero is accepted and means "use the default
+// weight"; the overall score overflow check happens when the framework is
+// built.
+func validateScorePluginWeights(path *field.Path, profile *config.KubeSchedulerProfile) []error {
+	var errs []error
+	if profile.Plugins == nil {
+		return errs
+	}
+	for i, plugin := range profile.Plugins.Score.Enabled {
+		if plugin.Weight < 0 {
+			errs = append(errs, field.Invalid(path.Child("plugins", "score", "enabled").Index(i).Child("weight"),
+				plugin.Weight, "must not be negative"))
+		}
+	}
 	return errs
 }
 
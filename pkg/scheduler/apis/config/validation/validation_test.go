@@ -254,6 +254,9 @@ func TestValidateKubeSchedulerConfiguration(t *testing.T) {
 	goodConflictPlugins2.Profiles[0].Plugins.Score.Enabled = append(goodConflictPlugins2.Profiles[0].Plugins.Score.Enabled, config.Plugin{Name: "NodeResourcesMostAllocated", Weight: 2})
 	goodConflictPlugins2.Profiles[0].Plugins.Score.Enabled = append(goodConflictPlugins2.Profiles[0].Plugins.Score.Enabled, config.Plugin{Name: "RequestedToCapacityRatio", Weight: 2})
 
+	negativeScorePluginWeight := validConfig.DeepCopy()
+	negativeScorePluginWeight.Profiles[0].Plugins.Score.Enabled = append(negativeScorePluginWeight.Profiles[0].Plugins.Score.Enabled, config.Plugin{Name: "NodeResourcesMostAllocated", Weight: -1})
+
 	deprecatedPluginsConfig := validConfig.DeepCopy()
 	deprecatedPluginsConfig.Profiles[0].PluginConfig = append(deprecatedPluginsConfig.Profiles[0].PluginConfig, config.PluginConfig{
 		Name: "NodeResourcesLeastAllocated",
@@ -317,6 +320,10 @@ func TestValidateKubeSchedulerConfiguration(t *testing.T) {
 			expectedToFail: true,
 			config:         repeatedSchedulerName,
 		},
+		"bad-negative-score-plugin-weight": {
+			expectedToFail: true,
+			config:         negativeScorePluginWeight,
+		},
 		"different-queue-sort": {
 			expectedToFail: true,
 			config:         differentQueueSort,
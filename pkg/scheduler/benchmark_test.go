@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kubernetes/pkg/scheduler/profile"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+)
+
+// BenchmarkScheduling runs the scheduler, with the default profile, against a
+// fake clientset pre-loaded with a synthetic cluster and measures end-to-end
+// scheduling throughput (pods scheduled per second, reported as "pods/s").
+// The node count and the fraction of pods carrying inter-pod affinity terms
+// are configurable per sub-benchmark so that performance changes such as
+// priority rewrites can be validated without a real cluster.
+func BenchmarkScheduling(b *testing.B) {
+	tests := []struct {
+		nodes       int
+		affinityPct int
+	}{
+		{nodes: 100, affinityPct: 0},
+		{nodes: 100, affinityPct: 30},
+		{nodes: 500, affinityPct: 0},
+		{nodes: 500, affinityPct: 30},
+	}
+	for _, test := range tests {
+		name := fmt.Sprintf("%vNodes/%vPctAffinity", test.nodes, test.affinityPct)
+		b.Run(name, func(b *testing.B) {
+			benchmarkScheduling(b, test.nodes, test.affinityPct)
+		})
+	}
+}
+
+func benchmarkScheduling(b *testing.B, nodeCount, affinityPct int) {
+	objs := []runtime.Object{&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bench"}}}
+	for i := 0; i < nodeCount; i++ {
+		node := st.MakeNode().
+			Name(fmt.Sprintf("node-%d", i)).
+			UID(fmt.Sprintf("node-%d", i)).
+			Label(v1.LabelHostname, fmt.Sprintf("node-%d", i)).
+			Label(v1.LabelTopologyZone, fmt.Sprintf("zone-%d", i%10)).
+			Capacity(map[v1.ResourceName]string{
+				v1.ResourceCPU:    "32",
+				v1.ResourceMemory: "64Gi",
+				v1.ResourcePods:   "110",
+			}).Obj()
+		objs = append(objs, node)
+	}
+	// Seed the pending pods into the clientset up front so they reach the
+	// scheduler through the informer's initial List. Creating them after the
+	// informers have started would push one watch event per pod through the
+	// fake watcher's fixed-size buffer, which overflows for large b.N.
+	for i := 0; i < b.N; i++ {
+		// The fake clientset does not default SchedulerName, so set it
+		// explicitly to match the default profile.
+		pod := st.MakePod().
+			Namespace("bench").
+			Name(fmt.Sprintf("pod-%d", i)).
+			UID(fmt.Sprintf("pod-%d", i)).
+			SchedulerName(v1.DefaultSchedulerName).
+			Label("app", "bench").
+			Req(map[v1.ResourceName]string{v1.ResourceCPU: "10m", v1.ResourceMemory: "10Mi"})
+		if affinityPct > 0 && i%100 < affinityPct {
+			pod = pod.PodAffinityExists("app", v1.LabelTopologyZone, st.PodAffinityWithPreferredReq)
+		}
+		objs = append(objs, pod.Obj())
+	}
+	client := clientsetfake.NewSimpleClientset(objs...)
+	broadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: client.EventsV1()})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	sched, err := New(
+		client,
+		informerFactory,
+		profile.NewRecorderFactory(broadcaster),
+		ctx.Done(),
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// Intercept bindings instead of polling the fake clientset.
+	var wg sync.WaitGroup
+	wg.Add(b.N)
+	client.PrependReactor("create", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "binding" {
+			return false, nil, nil
+		}
+		wg.Done()
+		return true, action.(clienttesting.CreateAction).GetObject(), nil
+	})
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	b.ResetTimer()
+	start := time.Now()
+	go sched.Run(ctx)
+	wg.Wait()
+	b.StopTimer()
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "pods/s")
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/feature"
 	plugintesting "k8s.io/kubernetes/pkg/scheduler/framework/plugins/testing"
 	"k8s.io/kubernetes/pkg/scheduler/internal/cache"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
 )
 
 var nsLabelT1 = map[string]string{"team": "team1"}
@@ -940,3 +941,54 @@ func TestPreferredAffinityWithHardPodAffinitySymmetricWeight(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkPreferredAffinity(b *testing.B) {
+	tests := []struct {
+		name            string
+		existingPodsNum int
+		allNodesNum     int
+	}{
+		{
+			name:            "100nodes",
+			existingPodsNum: 1000,
+			allNodesNum:     100,
+		},
+		{
+			name:            "1000nodes",
+			existingPodsNum: 10000,
+			allNodesNum:     1000,
+		},
+	}
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			pod := st.MakePod().Name("p").Label("foo", "").
+				PodAffinityExists("foo", "zone", st.PodAffinityWithPreferredReq).
+				PodAntiAffinityExists("foo", "az", st.PodAntiAffinityWithPreferredReq).Obj()
+			existingPods, allNodes, _ := st.MakeNodesAndPodsForEvenPodsSpread(pod.Labels, tt.existingPodsNum, tt.allNodesNum, tt.allNodesNum)
+			ctx := context.Background()
+			state := framework.NewCycleState()
+			snapshot := cache.NewSnapshot(existingPods, allNodes)
+			n := func(plArgs runtime.Object, fh framework.Handle) (framework.Plugin, error) {
+				return New(plArgs, fh, feature.Features{})
+			}
+			p := plugintesting.SetupPluginWithInformers(ctx, b, n, &config.InterPodAffinityArgs{HardPodAffinityWeight: 1}, snapshot, namespaces)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if status := p.(framework.PreScorePlugin).PreScore(ctx, state, pod, allNodes); !status.IsSuccess() {
+					b.Fatalf("unexpected error: %v", status)
+				}
+				gotList := make(framework.NodeScoreList, 0, len(allNodes))
+				for _, n := range allNodes {
+					score, status := p.(framework.ScorePlugin).Score(ctx, state, pod, n.Name)
+					if !status.IsSuccess() {
+						b.Fatalf("unexpected error: %v", status)
+					}
+					gotList = append(gotList, framework.NodeScore{Name: n.Name, Score: score})
+				}
+				if status := p.(framework.ScorePlugin).ScoreExtensions().NormalizeScore(ctx, state, pod, gotList); !status.IsSuccess() {
+					b.Fatalf("unexpected error: %v", status)
+				}
+			}
+		})
+	}
+}
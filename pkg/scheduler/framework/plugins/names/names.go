@@ -23,7 +23,9 @@ const (
 	ImageLocality                   = "ImageLocality"
 	InterPodAffinity                = "InterPodAffinity"
 	NodeAffinity                    = "NodeAffinity"
+	NodeCondition                   = "NodeCondition"
 	NodeLabel                       = "NodeLabel"
+	NodeMaintenance                 = "NodeMaintenance"
 	NodeName                        = "NodeName"
 	NodePorts                       = "NodePorts"
 	NodePreferAvoidPods             = "NodePreferAvoidPods"
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodecondition
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	v1helper "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
+)
+
+// NodeCondition is a plugin that filters out nodes reporting unhealthy
+// conditions: not Ready, unschedulable, or under memory/disk/PID pressure.
+// Clusters relying on condition-based taints get the same protection from
+// the TaintToleration plugin; this plugin covers setups where those taints
+// are not applied.
+type NodeCondition struct {
+}
+
+var _ framework.FilterPlugin = &NodeCondition{}
+var _ framework.EnqueueExtensions = &NodeCondition{}
+
+// Name is the name of the plugin used in the plugin registry and configurations.
+const Name = names.NodeCondition
+
+const (
+	// ErrReasonUnknownCondition is used when the nodeInfo has no node set.
+	ErrReasonUnknownCondition = "node(s) had unknown conditions"
+	// ErrReasonNotReady is used when a node's Ready condition is not true.
+	ErrReasonNotReady = "node(s) were not ready"
+	// ErrReasonUnschedulable is used when a node is marked unschedulable.
+	ErrReasonUnschedulable = "node(s) were unschedulable"
+	// ErrReasonMemoryPressure is used when a node reports memory pressure.
+	ErrReasonMemoryPressure = "node(s) had memory pressure"
+	// ErrReasonDiskPressure is used when a node reports disk pressure.
+	ErrReasonDiskPressure = "node(s) had disk pressure"
+	// ErrReasonPIDPressure is used when a node reports PID pressure.
+	ErrReasonPIDPressure = "node(s) had pid pressure"
+)
+
+// EventsToRegister returns the possible events that may make a Pod
+// failed by this plugin schedulable. Unschedulable flips are delivered as
+// UpdateNodeTaint events, so both action types are needed.
+func (pl *NodeCondition) EventsToRegister() []framework.ClusterEvent {
+	return []framework.ClusterEvent{
+		{Resource: framework.Node, ActionType: framework.Add | framework.UpdateNodeCondition | framework.UpdateNodeTaint},
+	}
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *NodeCondition) Name() string {
+	return Name
+}
+
+// Filter invoked at the filter extension point.
+func (pl *NodeCondition) Filter(ctx context.Context, _ *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	if nodeInfo == nil || nodeInfo.Node() == nil {
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonUnknownCondition)
+	}
+	node := nodeInfo.Node()
+	// Like the NodeUnschedulable plugin, a toleration for the unschedulable
+	// taint also tolerates node.Spec.Unschedulable.
+	podToleratesUnschedulable := v1helper.TolerationsTolerateTaint(pod.Spec.Tolerations, &v1.Taint{
+		Key:    v1.TaintNodeUnschedulable,
+		Effect: v1.TaintEffectNoSchedule,
+	})
+	if node.Spec.Unschedulable && !podToleratesUnschedulable {
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonUnschedulable)
+	}
+	for _, cond := range node.Status.Conditions {
+		switch cond.Type {
+		case v1.NodeReady:
+			if cond.Status != v1.ConditionTrue {
+				return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonNotReady)
+			}
+		case v1.NodeMemoryPressure:
+			if cond.Status == v1.ConditionTrue {
+				return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonMemoryPressure)
+			}
+		case v1.NodeDiskPressure:
+			if cond.Status == v1.ConditionTrue {
+				return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonDiskPressure)
+			}
+		case v1.NodePIDPressure:
+			if cond.Status == v1.ConditionTrue {
+				return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonPIDPressure)
+			}
+		}
+	}
+	return nil
+}
+
+// New initializes a new plugin and returns it.
+func New(_ runtime.Object, _ framework.Handle) (framework.Plugin, error) {
+	return &NodeCondition{}, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodecondition
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+func TestNodeCondition(t *testing.T) {
+	readyNode := func(extra ...v1.NodeCondition) *v1.Node {
+		return &v1.Node{
+			Status: v1.NodeStatus{
+				Conditions: append([]v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				}, extra...),
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		node       *v1.Node
+		pod        *v1.Pod
+		wantStatus *framework.Status
+	}{
+		{
+			name: "ready node",
+			node: readyNode(),
+		},
+		{
+			name: "node without conditions",
+			node: &v1.Node{},
+		},
+		{
+			name:       "not ready node",
+			node:       &v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}}},
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonNotReady),
+		},
+		{
+			name:       "ready condition unknown",
+			node:       &v1.Node{Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionUnknown}}}},
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonNotReady),
+		},
+		{
+			name:       "unschedulable node",
+			node:       &v1.Node{Spec: v1.NodeSpec{Unschedulable: true}},
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonUnschedulable),
+		},
+		{
+			name: "unschedulable node tolerated by the pod",
+			node: &v1.Node{Spec: v1.NodeSpec{Unschedulable: true}},
+			pod: &v1.Pod{Spec: v1.PodSpec{Tolerations: []v1.Toleration{
+				{Key: v1.TaintNodeUnschedulable, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+			}}},
+		},
+		{
+			name:       "memory pressure",
+			node:       readyNode(v1.NodeCondition{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue}),
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonMemoryPressure),
+		},
+		{
+			name:       "disk pressure",
+			node:       readyNode(v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue}),
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonDiskPressure),
+		},
+		{
+			name:       "pid pressure",
+			node:       readyNode(v1.NodeCondition{Type: v1.NodePIDPressure, Status: v1.ConditionTrue}),
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonPIDPressure),
+		},
+		{
+			name: "pressure conditions false",
+			node: readyNode(
+				v1.NodeCondition{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+				v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionFalse},
+				v1.NodeCondition{Type: v1.NodePIDPressure, Status: v1.ConditionFalse},
+			),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(test.node)
+			pod := test.pod
+			if pod == nil {
+				pod = &v1.Pod{}
+			}
+			p, _ := New(nil, nil)
+			gotStatus := p.(framework.FilterPlugin).Filter(context.Background(), nil, pod, nodeInfo)
+			if !gotStatus.Equal(test.wantStatus) {
+				t.Errorf("status does not match: %v, want: %v", gotStatus, test.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodemaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
+	"k8s.io/kubernetes/pkg/scheduler/util"
+)
+
+// MaintenanceWindowStartAnnotation is a node annotation holding the RFC 3339
+// timestamp at which the node is scheduled to be drained for maintenance.
+// It is typically set by drain/cordon tooling ahead of the actual drain.
+const MaintenanceWindowStartAnnotation = "node.alpha.kubernetes.io/maintenance-window-start"
+
+const (
+	// scoringHorizon is how far ahead of a maintenance window the plugin
+	// starts penalizing a node. Nodes whose window starts beyond the horizon
+	// score as if no window were declared.
+	scoringHorizon = time.Hour
+	// filterMargin is how close to the window start the plugin stops
+	// admitting new pods entirely.
+	filterMargin = 15 * time.Minute
+)
+
+// NodeMaintenance is a plugin that penalizes nodes with an upcoming
+// maintenance window declared via the MaintenanceWindowStartAnnotation
+// annotation, and filters out nodes whose window is imminent or has begun.
+type NodeMaintenance struct {
+	handle framework.Handle
+	clock  util.Clock
+}
+
+var _ framework.FilterPlugin = &NodeMaintenance{}
+var _ framework.ScorePlugin = &NodeMaintenance{}
+
+// Name is the name of the plugin used in the plugin registry and configurations.
+const Name = names.NodeMaintenance
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *NodeMaintenance) Name() string {
+	return Name
+}
+
+// maintenanceWindowStart returns the declared window start of the node, or
+// false when no valid window annotation is present. An unparsable annotation
+// is ignored so that a typo cannot make a node permanently unschedulable.
+func maintenanceWindowStart(node *v1.Node) (time.Time, bool) {
+	value, ok := node.Annotations[MaintenanceWindowStartAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	start, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// Filter invoked at the filter extension point.
+func (pl *NodeMaintenance) Filter(ctx context.Context, _ *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	node := nodeInfo.Node()
+	if node == nil {
+		return framework.AsStatus(fmt.Errorf("invalid nodeInfo"))
+	}
+	start, ok := maintenanceWindowStart(node)
+	if !ok {
+		return nil
+	}
+	if pl.clock.Now().After(start.Add(-filterMargin)) {
+		// Preemption cannot make the node admit the pod, so mark the
+		// failure unresolvable.
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, "node is about to enter a maintenance window")
+	}
+	return nil
+}
+
+// Score invoked at the score extension point.
+func (pl *NodeMaintenance) Score(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
+	nodeInfo, err := pl.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return 0, framework.AsStatus(fmt.Errorf("getting node %q from Snapshot: %w", nodeName, err))
+	}
+
+	start, ok := maintenanceWindowStart(nodeInfo.Node())
+	if !ok {
+		return framework.MaxNodeScore, nil
+	}
+	remaining := start.Sub(pl.clock.Now())
+	if remaining >= scoringHorizon {
+		return framework.MaxNodeScore, nil
+	}
+	if remaining <= 0 {
+		return 0, nil
+	}
+	// Scale linearly from 0 (window starting now) up to MaxNodeScore
+	// (window a full horizon away).
+	return framework.MaxNodeScore * int64(remaining) / int64(scoringHorizon), nil
+}
+
+// ScoreExtensions of the Score plugin.
+func (pl *NodeMaintenance) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// New initializes a new plugin and returns it.
+func New(_ runtime.Object, h framework.Handle) (framework.Plugin, error) {
+	return &NodeMaintenance{handle: h, clock: util.RealClock{}}, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodemaintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/internal/cache"
+)
+
+func nodeWithWindow(name string, start string) *v1.Node {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if start != "" {
+		node.Annotations = map[string]string{MaintenanceWindowStartAnnotation: start}
+	}
+	return node
+}
+
+func TestNodeMaintenanceScore(t *testing.T) {
+	now := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		node          *v1.Node
+		expectedScore int64
+	}{
+		{
+			name:          "no annotation",
+			node:          nodeWithWindow("machine1", ""),
+			expectedScore: framework.MaxNodeScore,
+		},
+		{
+			name:          "unparsable annotation is ignored",
+			node:          nodeWithWindow("machine1", "tomorrow-ish"),
+			expectedScore: framework.MaxNodeScore,
+		},
+		{
+			name:          "window beyond the scoring horizon",
+			node:          nodeWithWindow("machine1", now.Add(2*time.Hour).Format(time.RFC3339)),
+			expectedScore: framework.MaxNodeScore,
+		},
+		{
+			name:          "window halfway into the horizon",
+			node:          nodeWithWindow("machine1", now.Add(30*time.Minute).Format(time.RFC3339)),
+			expectedScore: framework.MaxNodeScore / 2,
+		},
+		{
+			name:          "window already started",
+			node:          nodeWithWindow("machine1", now.Add(-time.Minute).Format(time.RFC3339)),
+			expectedScore: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := framework.NewCycleState()
+			fh, _ := runtime.NewFramework(nil, nil, runtime.WithSnapshotSharedLister(cache.NewSnapshot(nil, []*v1.Node{test.node})))
+			p, _ := New(nil, fh)
+			p.(*NodeMaintenance).clock = clock.NewFakeClock(now)
+			score, status := p.(framework.ScorePlugin).Score(context.Background(), state, &v1.Pod{}, test.node.Name)
+			if !status.IsSuccess() {
+				t.Errorf("unexpected error: %v", status)
+			}
+			if score != test.expectedScore {
+				t.Errorf("expected score %d, got %d", test.expectedScore, score)
+			}
+		})
+	}
+}
+
+func TestNodeMaintenanceFilter(t *testing.T) {
+	now := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		node       *v1.Node
+		wantStatus *framework.Status
+	}{
+		{
+			name: "no annotation",
+			node: nodeWithWindow("machine1", ""),
+		},
+		{
+			name: "window well in the future",
+			node: nodeWithWindow("machine1", now.Add(time.Hour).Format(time.RFC3339)),
+		},
+		{
+			name:       "window imminent",
+			node:       nodeWithWindow("machine1", now.Add(5*time.Minute).Format(time.RFC3339)),
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, "node is about to enter a maintenance window"),
+		},
+		{
+			name:       "window in progress",
+			node:       nodeWithWindow("machine1", now.Add(-time.Hour).Format(time.RFC3339)),
+			wantStatus: framework.NewStatus(framework.UnschedulableAndUnresolvable, "node is about to enter a maintenance window"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(test.node)
+			fh, _ := runtime.NewFramework(nil, nil, runtime.WithSnapshotSharedLister(cache.NewSnapshot(nil, []*v1.Node{test.node})))
+			p, _ := New(nil, fh)
+			p.(*NodeMaintenance).clock = clock.NewFakeClock(now)
+			gotStatus := p.(framework.FilterPlugin).Filter(context.Background(), framework.NewCycleState(), &v1.Pod{}, nodeInfo)
+			if !gotStatus.Equal(test.wantStatus) {
+				t.Errorf("status does not match: %v, want: %v", gotStatus, test.wantStatus)
+			}
+		})
+	}
+}
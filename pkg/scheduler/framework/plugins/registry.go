@@ -27,7 +27,9 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/imagelocality"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/interpodaffinity"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeaffinity"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodecondition"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodelabel"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodemaintenance"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodename"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeports"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodepreferavoidpods"
@@ -60,6 +62,8 @@ func NewInTreeRegistry() runtime.Registry {
 		selectorspread.Name:      selectorspread.New,
 		imagelocality.Name:       imagelocality.New,
 		tainttoleration.Name:     tainttoleration.New,
+		nodecondition.Name:       nodecondition.New,
+		nodemaintenance.Name:     nodemaintenance.New,
 		nodename.Name:            nodename.New,
 		nodeports.Name:           nodeports.New,
 		nodepreferavoidpods.Name: nodepreferavoidpods.New,
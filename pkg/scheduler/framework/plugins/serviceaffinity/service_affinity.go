@@ -280,16 +280,19 @@ func (pl *ServiceAffinity) Filter(ctx context.Context, cycleState *framework.Cyc
 	filteredPods := nodeInfo.FilterOutPods(pods)
 	// check if the pod being scheduled has the affinity labels specified in its NodeSelector
 	affinityLabels := findLabelsInSet(pl.args.AffinityLabels, labels.Set(pod.Spec.NodeSelector))
-	// Step 1: If we don't have all constraints, introspect nodes to find the missing constraints.
+	// Step 1: If we don't have all constraints, introspect the nodes hosting
+	// already-scheduled peers to find the missing constraints.
 	if len(pl.args.AffinityLabels) > len(affinityLabels) {
-		if len(services) > 0 {
-			if len(filteredPods) > 0 {
-				nodeWithAffinityLabels, err := pl.sharedLister.NodeInfos().Get(filteredPods[0].Spec.NodeName)
+		if len(services) > 0 && len(filteredPods) > 0 {
+			nodeLabelSets := make([]labels.Set, 0, len(filteredPods))
+			for _, filteredPod := range filteredPods {
+				nodeWithAffinityLabels, err := pl.sharedLister.NodeInfos().Get(filteredPod.Spec.NodeName)
 				if err != nil {
 					return framework.AsStatus(fmt.Errorf("node not found"))
 				}
-				addUnsetLabelsToMap(affinityLabels, pl.args.AffinityLabels, labels.Set(nodeWithAffinityLabels.Node().Labels))
+				nodeLabelSets = append(nodeLabelSets, labels.Set(nodeWithAffinityLabels.Node().Labels))
 			}
+			addUnsetLabelsToMapFromAll(affinityLabels, pl.args.AffinityLabels, nodeLabelSets)
 		}
 	}
 	// Step 2: Finally complete the affinity predicate based on whatever set of predicates we were able to find.
@@ -312,15 +315,20 @@ func (pl *ServiceAffinity) Score(ctx context.Context, state *framework.CycleStat
 		return 0, framework.AsStatus(fmt.Errorf("node not found"))
 	}
 
-	// Pods matched namespace,selector on current node.
-	var selector labels.Selector
-	if services, err := helper.GetPodServices(pl.serviceLister, pod); err == nil && len(services) > 0 {
-		selector = labels.SelectorFromSet(services[0].Spec.Selector)
-	} else {
-		selector = labels.NewSelector()
+	// Pods matched by any service selecting the incoming pod, on the current
+	// node. Considering the union of all services (instead of just the first
+	// one) keeps the score independent of the order services are listed in.
+	var selectors []labels.Selector
+	if services, err := helper.GetPodServices(pl.serviceLister, pod); err == nil {
+		for _, service := range services {
+			if len(service.Spec.Selector) == 0 {
+				continue
+			}
+			selectors = append(selectors, labels.SelectorFromSet(service.Spec.Selector))
+		}
 	}
 
-	if len(nodeInfo.Pods) == 0 || selector.Empty() {
+	if len(nodeInfo.Pods) == 0 || len(selectors) == 0 {
 		return 0, nil
 	}
 	var score int64
@@ -328,8 +336,11 @@ func (pl *ServiceAffinity) Score(ctx context.Context, state *framework.CycleStat
 		// Ignore pods being deleted for spreading purposes
 		// Similar to how it is done for SelectorSpreadPriority
 		if pod.Namespace == existingPod.Pod.Namespace && existingPod.Pod.DeletionTimestamp == nil {
-			if selector.Matches(labels.Set(existingPod.Pod.Labels)) {
-				score++
+			for _, selector := range selectors {
+				if selector.Matches(labels.Set(existingPod.Pod.Labels)) {
+					score++
+					break
+				}
 			}
 		}
 	}
@@ -412,16 +423,24 @@ func (pl *ServiceAffinity) ScoreExtensions() framework.ScoreExtensions {
 	return pl
 }
 
-// addUnsetLabelsToMap backfills missing values with values we find in a map.
-func addUnsetLabelsToMap(aL map[string]string, labelsToAdd []string, labelSet labels.Set) {
+// addUnsetLabelsToMapFromAll backfills missing values with values found in the
+// given label sets. When the sets disagree on a value, the lexicographically
+// smallest one wins, so that the result does not depend on the order the
+// already-scheduled peers were observed in.
+func addUnsetLabelsToMapFromAll(aL map[string]string, labelsToAdd []string, labelSets []labels.Set) {
 	for _, l := range labelsToAdd {
 		// if the label is already there, don't overwrite it.
 		if _, exists := aL[l]; exists {
 			continue
 		}
 		// otherwise, backfill this label.
-		if labelSet.Has(l) {
-			aL[l] = labelSet.Get(l)
+		for _, labelSet := range labelSets {
+			if !labelSet.Has(l) {
+				continue
+			}
+			if v, exists := aL[l]; !exists || labelSet.Get(l) < v {
+				aL[l] = labelSet.Get(l)
+			}
 		}
 	}
 }
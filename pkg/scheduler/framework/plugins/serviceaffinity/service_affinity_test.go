@@ -287,6 +287,23 @@ func TestServiceAffinityScore(t *testing.T) {
 				{Name: "machine01", Score: 0}, {Name: "machine02", Score: 0}},
 			name: "three pods, one service pod",
 		},
+		{
+			pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: labels1}},
+			pods: []*v1.Pod{
+				{Spec: zone1Spec, ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}}},
+				{Spec: zone2Spec, ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"baz": "blah"}}},
+			},
+			nodes:  labeledNodes,
+			labels: []string{"zone"},
+			services: []*v1.Service{
+				{Spec: v1.ServiceSpec{Selector: map[string]string{"foo": "bar"}}},
+				{Spec: v1.ServiceSpec{Selector: map[string]string{"baz": "blah"}}},
+			},
+			expectedList: []framework.NodeScore{{Name: "machine11", Score: 50}, {Name: "machine12", Score: 50},
+				{Name: "machine21", Score: 50}, {Name: "machine22", Score: 50},
+				{Name: "machine01", Score: 0}, {Name: "machine02", Score: 0}},
+			name: "two services, peers of both services counted",
+		},
 		{
 			pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: labels1}},
 			pods: []*v1.Pod{
@@ -18,6 +18,7 @@ package tainttoleration
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -340,3 +341,61 @@ func TestTaintTolerationFilter(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkTaintTolerationScore(b *testing.B) {
+	tests := []struct {
+		name        string
+		allNodesNum int
+	}{
+		{
+			name:        "100nodes",
+			allNodesNum: 100,
+		},
+		{
+			name:        "1000nodes",
+			allNodesNum: 1000,
+		},
+	}
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			pod := podWithTolerations("pod1", []v1.Toleration{
+				{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "team-0", Effect: v1.TaintEffectPreferNoSchedule},
+			})
+			nodes := make([]*v1.Node, 0, tt.allNodesNum)
+			for i := 0; i < tt.allNodesNum; i++ {
+				// Every other node carries intolerable soft taints so both
+				// scoring branches are exercised.
+				var taints []v1.Taint
+				if i%2 == 0 {
+					taints = []v1.Taint{
+						{Key: "dedicated", Value: fmt.Sprintf("team-%d", i%10), Effect: v1.TaintEffectPreferNoSchedule},
+						{Key: "spot", Value: "true", Effect: v1.TaintEffectPreferNoSchedule},
+					}
+				}
+				nodes = append(nodes, nodeWithTaints(fmt.Sprintf("node-%d", i), taints))
+			}
+			state := framework.NewCycleState()
+			snapshot := cache.NewSnapshot(nil, nodes)
+			fh, _ := runtime.NewFramework(nil, nil, runtime.WithSnapshotSharedLister(snapshot))
+			p, _ := New(nil, fh)
+			ctx := context.Background()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if status := p.(framework.PreScorePlugin).PreScore(ctx, state, pod, nodes); !status.IsSuccess() {
+					b.Fatalf("unexpected error: %v", status)
+				}
+				gotList := make(framework.NodeScoreList, 0, len(nodes))
+				for _, n := range nodes {
+					score, status := p.(framework.ScorePlugin).Score(ctx, state, pod, n.Name)
+					if !status.IsSuccess() {
+						b.Fatalf("unexpected error: %v", status)
+					}
+					gotList = append(gotList, framework.NodeScore{Name: n.Name, Score: score})
+				}
+				if status := p.(framework.ScorePlugin).ScoreExtensions().NormalizeScore(ctx, state, pod, gotList); !status.IsSuccess() {
+					b.Fatalf("unexpected error: %v", status)
+				}
+			}
+		})
+	}
+}
@@ -837,6 +837,14 @@ func GetPodKey(pod *v1.Pod) (string, error) {
 // DefaultBindAllHostIP defines the default ip address used to bind to all host.
 const DefaultBindAllHostIP = "0.0.0.0"
 
+// DefaultBindAllHostIPv6 defines the IPv6 address used to bind to all host.
+const DefaultBindAllHostIPv6 = "::"
+
+// isBindAllIP returns whether the ip binds to all host addresses.
+func isBindAllIP(ip string) bool {
+	return ip == DefaultBindAllHostIP || ip == DefaultBindAllHostIPv6
+}
+
 // ProtocolPort represents a protocol port pair, e.g. tcp:80.
 type ProtocolPort struct {
 	Protocol string
@@ -916,8 +924,8 @@ func (h HostPortInfo) CheckConflict(ip, protocol string, port int32) bool {
 
 	pp := NewProtocolPort(protocol, port)
 
-	// If ip is 0.0.0.0 check all IP's (protocol, port) pair
-	if ip == DefaultBindAllHostIP {
+	// If ip is a bind-all address (0.0.0.0 or ::), check all IPs' (protocol, port) pair
+	if isBindAllIP(ip) {
 		for _, m := range h {
 			if _, ok := m[*pp]; ok {
 				return true
@@ -926,8 +934,8 @@ func (h HostPortInfo) CheckConflict(ip, protocol string, port int32) bool {
 		return false
 	}
 
-	// If ip isn't 0.0.0.0, only check IP and 0.0.0.0's (protocol, port) pair
-	for _, key := range []string{DefaultBindAllHostIP, ip} {
+	// If ip isn't a bind-all address, only check IP and the bind-all addresses' (protocol, port) pair
+	for _, key := range []string{DefaultBindAllHostIP, DefaultBindAllHostIPv6, ip} {
 		if m, ok := h[key]; ok {
 			if _, ok2 := m[*pp]; ok2 {
 				return true
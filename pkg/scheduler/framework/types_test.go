@@ -1261,6 +1261,30 @@ func TestHostPortInfo_Check(t *testing.T) {
 			check:  hostPortInfoParam{"", "", 0},
 			expect: false,
 		},
+		{
+			desc: ":: should check all registered IPs",
+			added: []hostPortInfoParam{
+				{"TCP", "2001:db8::1", 80},
+			},
+			check:  hostPortInfoParam{"TCP", "::", 80},
+			expect: true,
+		},
+		{
+			desc: "registered :: should conflict with any IP",
+			added: []hostPortInfoParam{
+				{"TCP", "::", 80},
+			},
+			check:  hostPortInfoParam{"TCP", "2001:db8::1", 80},
+			expect: true,
+		},
+		{
+			desc: "registered :: with different port should be allowed",
+			added: []hostPortInfoParam{
+				{"TCP", "::", 80},
+			},
+			check:  hostPortInfoParam{"TCP", "2001:db8::1", 81},
+			expect: false,
+		},
 		{
 			desc: "0.0.0.0 should check all registered IPs",
 			added: []hostPortInfoParam{
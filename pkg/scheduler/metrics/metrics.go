@@ -164,6 +164,16 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		}, []string{"type"})
 
+	// LastSuccessfulBindingTimestamp, together with the pending_pods queue
+	// depths, lets liveness tooling tell an idle scheduler from a stuck one.
+	LastSuccessfulBindingTimestamp = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      SchedulerSubsystem,
+			Name:           "last_successful_binding_timestamp_seconds",
+			Help:           "Unix timestamp of the most recent successful pod binding.",
+			StabilityLevel: metrics.ALPHA,
+		})
+
 	metricsList = []metrics.Registerable{
 		scheduleAttempts,
 		e2eSchedulingLatency,
@@ -179,6 +189,7 @@ var (
 		SchedulerGoroutines,
 		PermitWaitDuration,
 		CacheSize,
+		LastSuccessfulBindingTimestamp,
 	}
 )
 
@@ -54,6 +54,7 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/metrics"
 	"k8s.io/kubernetes/pkg/scheduler/profile"
 	"k8s.io/kubernetes/pkg/scheduler/util"
+	utiltrace "k8s.io/utils/trace"
 )
 
 const (
@@ -515,6 +516,12 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 
 	klog.V(3).InfoS("Attempting to schedule pod", "pod", klog.KObj(pod))
 
+	trace := utiltrace.New("SchedulingContext",
+		utiltrace.Field{Key: "namespace", Value: pod.Namespace},
+		utiltrace.Field{Key: "name", Value: pod.Name},
+		utiltrace.Field{Key: "profile", Value: fwk.ProfileName()})
+	defer trace.LogIfLong(100 * time.Millisecond)
+
 	// Synchronously attempt to find a fit for the pod.
 	start := time.Now()
 	state := framework.NewCycleState()
@@ -526,6 +533,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 	schedulingCycleCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	scheduleResult, err := sched.Algorithm.Schedule(schedulingCycleCtx, sched.Extenders, fwk, state, pod)
+	trace.Step("Computing pod placement done")
 	if err != nil {
 		// Schedule() may have failed because the pod would not fit on any host, so we try to
 		// preempt, with the expectation that the next time the pod is tried for scheduling it
@@ -538,6 +546,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 			} else {
 				// Run PostFilter plugins to try to make the pod schedulable in a future scheduling cycle.
 				result, status := fwk.RunPostFilterPlugins(ctx, state, pod, fitError.Diagnosis.NodeToStatusMap)
+				trace.Step("Running PostFilter plugins done")
 				if status.Code() == framework.Error {
 					klog.ErrorS(nil, "Status after running PostFilter plugins for pod", klog.KObj(pod), "status", status)
 				} else {
@@ -568,6 +577,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 	assumedPod := assumedPodInfo.Pod
 	// assume modifies `assumedPod` by setting NodeName=scheduleResult.SuggestedHost
 	err = sched.assume(assumedPod, scheduleResult.SuggestedHost)
+	trace.Step("Assuming pod in cache done")
 	if err != nil {
 		metrics.PodScheduleError(fwk.ProfileName(), metrics.SinceInSeconds(start))
 		// This is most probably result of a BUG in retrying logic.
@@ -580,7 +590,9 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 	}
 
 	// Run the Reserve method of reserve plugins.
-	if sts := fwk.RunReservePluginsReserve(schedulingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost); !sts.IsSuccess() {
+	sts := fwk.RunReservePluginsReserve(schedulingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost)
+	trace.Step("Running Reserve plugins done")
+	if !sts.IsSuccess() {
 		metrics.PodScheduleError(fwk.ProfileName(), metrics.SinceInSeconds(start))
 		// trigger un-reserve to clean up state associated with the reserved Pod
 		fwk.RunReservePluginsUnreserve(schedulingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost)
@@ -593,6 +605,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 
 	// Run "permit" plugins.
 	runPermitStatus := fwk.RunPermitPlugins(schedulingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost)
+	trace.Step("Running Permit plugins done")
 	if runPermitStatus.Code() != framework.Wait && !runPermitStatus.IsSuccess() {
 		var reason string
 		if runPermitStatus.IsUnschedulable() {
@@ -625,7 +638,14 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 		metrics.SchedulerGoroutines.WithLabelValues(metrics.Binding).Inc()
 		defer metrics.SchedulerGoroutines.WithLabelValues(metrics.Binding).Dec()
 
+		bindingTrace := utiltrace.New("BindingContext",
+			utiltrace.Field{Key: "namespace", Value: assumedPod.Namespace},
+			utiltrace.Field{Key: "name", Value: assumedPod.Name},
+			utiltrace.Field{Key: "node", Value: scheduleResult.SuggestedHost})
+		defer bindingTrace.LogIfLong(100 * time.Millisecond)
+
 		waitOnPermitStatus := fwk.WaitOnPermit(bindingCycleCtx, assumedPod)
+		bindingTrace.Step("Waiting on Permit done")
 		if !waitOnPermitStatus.IsSuccess() {
 			var reason string
 			if waitOnPermitStatus.IsUnschedulable() {
@@ -646,6 +666,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 
 		// Run "prebind" plugins.
 		preBindStatus := fwk.RunPreBindPlugins(bindingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost)
+		bindingTrace.Step("Running PreBind plugins done")
 		if !preBindStatus.IsSuccess() {
 			metrics.PodScheduleError(fwk.ProfileName(), metrics.SinceInSeconds(start))
 			// trigger un-reserve plugins to clean up state associated with the reserved Pod
@@ -658,6 +679,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 		}
 
 		err := sched.bind(bindingCycleCtx, fwk, assumedPod, scheduleResult.SuggestedHost, state)
+		bindingTrace.Step("Binding pod done")
 		if err != nil {
 			metrics.PodScheduleError(fwk.ProfileName(), metrics.SinceInSeconds(start))
 			// trigger un-reserve plugins to clean up state associated with the reserved Pod
@@ -672,6 +694,7 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 				klog.InfoS("Successfully bound pod to node", "pod", klog.KObj(pod), "node", scheduleResult.SuggestedHost, "evaluatedNodes", scheduleResult.EvaluatedNodes, "feasibleNodes", scheduleResult.FeasibleNodes)
 			}
 			metrics.PodScheduled(fwk.ProfileName(), metrics.SinceInSeconds(start))
+			metrics.LastSuccessfulBindingTimestamp.SetToCurrentTime()
 			metrics.PodSchedulingAttempts.Observe(float64(podInfo.Attempts))
 			metrics.PodSchedulingDuration.WithLabelValues(getAttemptsLabel(podInfo)).Observe(metrics.SinceInSeconds(podInfo.InitialAttemptTimestamp))
 
@@ -514,6 +514,112 @@ func (s *fakeNodeSelector) Filter(_ context.Context, _ *framework.CycleState, _
 	return nil
 }
 
+// TestSchedulerProfilesWithDifferentScoringStrategies verifies that the
+// least- and most-allocated resource scoring strategies are selectable per
+// profile through NodeResourcesFit args in the same scheduler, by scheduling
+// one pod per profile against nodes with asymmetric utilization and checking
+// that the profiles pick opposite nodes.
+func TestSchedulerProfilesWithDifferentScoringStrategies(t *testing.T) {
+	nodes := []runtime.Object{
+		st.MakeNode().Name("node-busy").UID("node-busy").
+			Capacity(map[v1.ResourceName]string{v1.ResourceCPU: "8", v1.ResourceMemory: "16Gi", v1.ResourcePods: "32"}).Obj(),
+		st.MakeNode().Name("node-idle").UID("node-idle").
+			Capacity(map[v1.ResourceName]string{v1.ResourceCPU: "8", v1.ResourceMemory: "16Gi", v1.ResourcePods: "32"}).Obj(),
+	}
+	// An already-running pod keeps node-busy at ~half utilization.
+	runningPod := st.MakePod().Namespace("ns1").Name("running").UID("running").Node("node-busy").
+		Req(map[v1.ResourceName]string{v1.ResourceCPU: "4", v1.ResourceMemory: "8Gi"}).Obj()
+	pods := []*v1.Pod{
+		st.MakePod().Namespace("ns1").Name("pod-least").UID("pod-least").SchedulerName("least-allocated").
+			Req(map[v1.ResourceName]string{v1.ResourceCPU: "1", v1.ResourceMemory: "1Gi"}).Obj(),
+		st.MakePod().Namespace("ns1").Name("pod-most").UID("pod-most").SchedulerName("most-allocated").
+			Req(map[v1.ResourceName]string{v1.ResourceCPU: "1", v1.ResourceMemory: "1Gi"}).Obj(),
+	}
+	wantBindings := map[string]string{
+		"pod-least": "node-idle",
+		"pod-most":  "node-busy",
+	}
+
+	fitArgs := func(strategy schedulerapi.ScoringStrategyType) *schedulerapi.NodeResourcesFitArgs {
+		return &schedulerapi.NodeResourcesFitArgs{
+			ScoringStrategy: &schedulerapi.ScoringStrategy{
+				Type: strategy,
+				Resources: []schedulerapi.ResourceSpec{
+					{Name: "cpu", Weight: 1},
+					{Name: "memory", Weight: 1},
+				},
+			},
+		}
+	}
+	profileFor := func(name string, strategy schedulerapi.ScoringStrategyType) schedulerapi.KubeSchedulerProfile {
+		return schedulerapi.KubeSchedulerProfile{
+			SchedulerName: name,
+			Plugins: &schedulerapi.Plugins{
+				QueueSort: schedulerapi.PluginSet{Enabled: []schedulerapi.Plugin{{Name: "PrioritySort"}}},
+				Filter:    schedulerapi.PluginSet{Enabled: []schedulerapi.Plugin{{Name: "NodeResourcesFit"}}},
+				PreFilter: schedulerapi.PluginSet{Enabled: []schedulerapi.Plugin{{Name: "NodeResourcesFit"}}},
+				Score:     schedulerapi.PluginSet{Enabled: []schedulerapi.Plugin{{Name: "NodeResourcesFit"}}},
+				Bind:      schedulerapi.PluginSet{Enabled: []schedulerapi.Plugin{{Name: "DefaultBinder"}}},
+			},
+			PluginConfig: []schedulerapi.PluginConfig{
+				{Name: "NodeResourcesFit", Args: fitArgs(strategy)},
+			},
+		}
+	}
+
+	objs := append([]runtime.Object{&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}, runningPod}, nodes...)
+	client := clientsetfake.NewSimpleClientset(objs...)
+	broadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: client.EventsV1()})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	sched, err := New(
+		client,
+		informerFactory,
+		profile.NewRecorderFactory(broadcaster),
+		ctx.Done(),
+		WithProfiles(
+			profileFor("least-allocated", schedulerapi.LeastAllocated),
+			profileFor("most-allocated", schedulerapi.MostAllocated),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(pods))
+	var mu sync.Mutex
+	bindings := make(map[string]string)
+	client.PrependReactor("create", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "binding" {
+			return false, nil, nil
+		}
+		binding := action.(clienttesting.CreateAction).GetObject().(*v1.Binding)
+		mu.Lock()
+		bindings[binding.Name] = binding.Target.Name
+		mu.Unlock()
+		wg.Done()
+		return true, binding, nil
+	})
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+	go sched.Run(ctx)
+
+	for _, p := range pods {
+		if _, err := client.CoreV1().Pods("ns1").Create(ctx, p, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+
+	if diff := cmp.Diff(wantBindings, bindings); diff != "" {
+		t.Errorf("pods were scheduled incorrectly (-want, +got):\n%s", diff)
+	}
+}
+
 func TestSchedulerMultipleProfilesScheduling(t *testing.T) {
 	nodes := []runtime.Object{
 		st.MakeNode().Name("machine1").UID("machine1").Obj(),
@@ -639,6 +745,72 @@ func TestSchedulerMultipleProfilesScheduling(t *testing.T) {
 	}
 }
 
+// TestSchedulerRequeueAfterTaintRemoval verifies that a pod kept Pending only
+// by an untolerated taint is rescheduled once the taint is removed from the
+// node.
+func TestSchedulerRequeueAfterTaintRemoval(t *testing.T) {
+	node := st.MakeNode().Name("machine1").UID("machine1").
+		Capacity(map[v1.ResourceName]string{v1.ResourcePods: "32"}).Obj()
+	node.Spec.Taints = []v1.Taint{{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule}}
+	pod := st.MakePod().Namespace("ns1").Name("pod1").UID("pod1").SchedulerName(v1.DefaultSchedulerName).Obj()
+
+	objs := []runtime.Object{&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}, node, pod}
+	client := clientsetfake.NewSimpleClientset(objs...)
+	broadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: client.EventsV1()})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	sched, err := New(
+		client,
+		informerFactory,
+		profile.NewRecorderFactory(broadcaster),
+		ctx.Done(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bindings := make(chan *v1.Binding, 1)
+	client.PrependReactor("create", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "binding" {
+			return false, nil, nil
+		}
+		binding := action.(clienttesting.CreateAction).GetObject().(*v1.Binding)
+		bindings <- binding
+		return true, binding, nil
+	})
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+	go sched.Run(ctx)
+
+	// The pod must stay pending while the taint is in place.
+	select {
+	case b := <-bindings:
+		t.Fatalf("pod got bound to %q despite untolerated taint", b.Target.Name)
+	case <-time.After(time.Second):
+	}
+
+	// Removing the taint should move the pod back to the active queue and
+	// let it schedule.
+	untainted := node.DeepCopy()
+	untainted.Spec.Taints = nil
+	untainted.ResourceVersion = "2"
+	if _, err := client.CoreV1().Nodes().Update(ctx, untainted, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case b := <-bindings:
+		if b.Target.Name != "machine1" {
+			t.Errorf("expected binding to machine1, got %q", b.Target.Name)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("pod was not rescheduled after taint removal")
+	}
+}
+
 func TestSchedulerNoPhantomPodAfterExpire(t *testing.T) {
 	stop := make(chan struct{})
 	defer close(stop)
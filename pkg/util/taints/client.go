@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+	clientretry "k8s.io/client-go/util/retry"
+)
+
+// UpdateTaintBackoff is the backoff applied when retrying conflicting taint
+// updates against the API server.
+var UpdateTaintBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 100 * time.Millisecond,
+	Jitter:   1.0,
+}
+
+// Client is a client-go style typed accessor for the taints of Node objects.
+// It wraps the slice helpers in this package with conflict-retrying API
+// calls, so callers can manage node taints without re-implementing the
+// get/mutate/patch loop.
+type Client struct {
+	client  clientset.Interface
+	backoff wait.Backoff
+}
+
+// NewClient returns a taints Client operating through the given clientset
+// with the default update backoff.
+func NewClient(c clientset.Interface) *Client {
+	return &Client{client: c, backoff: UpdateTaintBackoff}
+}
+
+// AddOrUpdate adds taints to the named node, overwriting existing taints
+// with the same key and effect. API calls are only issued when a taint
+// actually changes. Return error if any.
+func (tc *Client) AddOrUpdate(ctx context.Context, nodeName string, taints ...*v1.Taint) error {
+	if len(taints) == 0 {
+		return nil
+	}
+	return tc.update(ctx, nodeName, taints, func(node *v1.Node, taint *v1.Taint) (*v1.Node, bool, error) {
+		newNode, updated, err := AddOrUpdateTaint(node, taint)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to update taint of node")
+		}
+		return newNode, updated, nil
+	})
+}
+
+// Remove removes taints from the named node. It won't fail if a target taint
+// doesn't exist or has been removed. If a node object is passed, it is used
+// to short circuit API calls when none of the taints are present.
+func (tc *Client) Remove(ctx context.Context, nodeName string, node *v1.Node, taints ...*v1.Taint) error {
+	if len(taints) == 0 {
+		return nil
+	}
+	// Short circuit for limiting amount of API calls.
+	if node != nil {
+		match := false
+		for _, taint := range taints {
+			if TaintExists(node.Spec.Taints, taint) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return nil
+		}
+	}
+
+	return tc.update(ctx, nodeName, taints, func(node *v1.Node, taint *v1.Taint) (*v1.Node, bool, error) {
+		newNode, updated, err := RemoveTaint(node, taint)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to remove taint of node")
+		}
+		return newNode, updated, nil
+	})
+}
+
+// update fetches the node, applies the given mutation for each taint, and
+// patches the result back, retrying on conflicts.
+func (tc *Client) update(ctx context.Context, nodeName string, taints []*v1.Taint, mutate func(*v1.Node, *v1.Taint) (*v1.Node, bool, error)) error {
+	firstTry := true
+	return clientretry.RetryOnConflict(tc.backoff, func() error {
+		var err error
+		var oldNode *v1.Node
+		// First we try getting node from the API server cache, as it's cheaper. If it fails
+		// we get it from etcd to be sure to have fresh data.
+		if firstTry {
+			oldNode, err = tc.client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{ResourceVersion: "0"})
+			firstTry = false
+		} else {
+			oldNode, err = tc.client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		}
+		if err != nil {
+			return err
+		}
+
+		var newNode *v1.Node
+		oldNodeCopy := oldNode
+		updated := false
+		for _, taint := range taints {
+			curNewNode, ok, err := mutate(oldNodeCopy, taint)
+			if err != nil {
+				return err
+			}
+			updated = updated || ok
+			newNode = curNewNode
+			oldNodeCopy = curNewNode
+		}
+		if !updated {
+			return nil
+		}
+		return tc.Patch(ctx, nodeName, oldNode, newNode)
+	})
+}
+
+// Patch patches the named node's taints to those of newNode.
+func (tc *Client) Patch(ctx context.Context, nodeName string, oldNode *v1.Node, newNode *v1.Node) error {
+	oldData, err := json.Marshal(oldNode)
+	if err != nil {
+		return fmt.Errorf("failed to marshal old node %#v for node %q: %v", oldNode, nodeName, err)
+	}
+
+	newTaints := newNode.Spec.Taints
+	newNodeClone := oldNode.DeepCopy()
+	newNodeClone.Spec.Taints = newTaints
+	newData, err := json.Marshal(newNodeClone)
+	if err != nil {
+		return fmt.Errorf("failed to marshal new node %#v for node %q: %v", newNodeClone, nodeName, err)
+	}
+
+	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, v1.Node{})
+	if err != nil {
+		return fmt.Errorf("failed to create patch for node %q: %v", nodeName, err)
+	}
+
+	_, err = tc.client.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taints
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClientAddOrUpdateAndRemove(t *testing.T) {
+	taint := &v1.Taint{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	client := fake.NewSimpleClientset(node)
+	tc := NewClient(client)
+
+	if err := tc.AddOrUpdate(context.TODO(), "node1", taint); err != nil {
+		t.Fatalf("AddOrUpdate failed: %v", err)
+	}
+	got, err := client.CoreV1().Nodes().Get(context.TODO(), "node1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []v1.Taint{*taint}; !reflect.DeepEqual(got.Spec.Taints, want) {
+		t.Errorf("expected taints %v, got %v", want, got.Spec.Taints)
+	}
+
+	// Removing a taint that is not present must not error.
+	if err := tc.Remove(context.TODO(), "node1", nil, &v1.Taint{Key: "other", Effect: v1.TaintEffectNoSchedule}); err != nil {
+		t.Errorf("Remove of absent taint failed: %v", err)
+	}
+
+	if err := tc.Remove(context.TODO(), "node1", nil, taint); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	got, err = client.CoreV1().Nodes().Get(context.TODO(), "node1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Spec.Taints) != 0 {
+		t.Errorf("expected no taints, got %v", got.Spec.Taints)
+	}
+}
+
+func TestClientRemoveShortCircuit(t *testing.T) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	client := fake.NewSimpleClientset(node)
+	tc := NewClient(client)
+
+	// With the node object passed and no matching taints, no API calls
+	// should be issued.
+	if err := tc.Remove(context.TODO(), "node1", node, &v1.Taint{Key: "absent", Effect: v1.TaintEffectNoSchedule}); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("expected no API calls, got %v", actions)
+	}
+}
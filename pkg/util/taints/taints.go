@@ -25,6 +25,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 	api "k8s.io/kubernetes/pkg/apis/core"
 	"k8s.io/kubernetes/pkg/apis/core/helper"
 )
@@ -81,11 +82,9 @@ func parseTaint(st string) (v1.Taint, error) {
 }
 
 func validateTaintEffect(effect v1.TaintEffect) error {
-	if effect != v1.TaintEffectNoSchedule && effect != v1.TaintEffectPreferNoSchedule && effect != v1.TaintEffectNoExecute {
-		return fmt.Errorf("invalid taint effect: %v, unsupported taint effect", effect)
-	}
-
-	return nil
+	// Delegate to the shared helper so all taint tooling agrees on the
+	// allowed set.
+	return corev1helpers.ValidateTaintEffect(effect)
 }
 
 // NewTaintsVar wraps []api.Taint in a struct that implements flag.Value to allow taints to be
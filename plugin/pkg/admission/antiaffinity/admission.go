@@ -20,9 +20,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"reflect"
 
 	"k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/apiserver/pkg/admission"
 	api "k8s.io/kubernetes/pkg/apis/core"
 )
@@ -33,26 +36,65 @@ const PluginName = "LimitPodHardAntiAffinityTopology"
 // Register registers a plugin
 func Register(plugins *admission.Plugins) {
 	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
-		return NewInterPodAntiAffinity(), nil
+		// TODO move this to a versioned configuration file format.
+		pluginConfig := readConfig(config)
+		return NewInterPodAntiAffinity(pluginConfig.PodHardAntiAffinityTopologyKeys), nil
 	})
 }
 
 // Plugin contains the client used by the admission controller
 type Plugin struct {
 	*admission.Handler
+	// allowedTopologyKeys are the topology keys accepted in hard pod
+	// anti-affinity terms. Defaults to {v1.LabelHostname}.
+	allowedTopologyKeys sets.String
 }
 
 var _ admission.ValidationInterface = &Plugin{}
 
-// NewInterPodAntiAffinity creates a new instance of the LimitPodHardAntiAffinityTopology admission controller
-func NewInterPodAntiAffinity() *Plugin {
+type pluginConfig struct {
+	PodHardAntiAffinityTopologyKeys []string
+}
+
+// readConfig reads the allowed topology keys from the file provided with
+// --admission-control-config-file. If the file is not supplied, the plugin
+// only allows v1.LabelHostname.
+// The format in a file:
+// podHardAntiAffinityTopologyKeys:
+// - kubernetes.io/hostname
+// - topology.kubernetes.io/zone
+func readConfig(config io.Reader) *pluginConfig {
+	defaultConfig := &pluginConfig{}
+	if config == nil || reflect.ValueOf(config).IsNil() {
+		return defaultConfig
+	}
+	d := yaml.NewYAMLOrJSONDecoder(config, 4096)
+	for {
+		if err := d.Decode(defaultConfig); err != nil {
+			if err != io.EOF {
+				continue
+			}
+		}
+		break
+	}
+	return defaultConfig
+}
+
+// NewInterPodAntiAffinity creates a new instance of the LimitPodHardAntiAffinityTopology admission controller.
+// When allowedTopologyKeys is empty it defaults to allowing only v1.LabelHostname.
+func NewInterPodAntiAffinity(allowedTopologyKeys []string) *Plugin {
+	if len(allowedTopologyKeys) == 0 {
+		allowedTopologyKeys = []string{v1.LabelHostname}
+	}
 	return &Plugin{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler:             admission.NewHandler(admission.Create, admission.Update),
+		allowedTopologyKeys: sets.NewString(allowedTopologyKeys...),
 	}
 }
 
-// Validate will deny any pod that defines AntiAffinity topology key other than v1.LabelHostname i.e. "kubernetes.io/hostname"
-// in  requiredDuringSchedulingRequiredDuringExecution and requiredDuringSchedulingIgnoredDuringExecution.
+// Validate will deny any pod that defines an AntiAffinity topology key outside the allowed set
+// (by default only v1.LabelHostname i.e. "kubernetes.io/hostname")
+// in requiredDuringSchedulingRequiredDuringExecution and requiredDuringSchedulingIgnoredDuringExecution.
 func (p *Plugin) Validate(ctx context.Context, attributes admission.Attributes, o admission.ObjectInterfaces) (err error) {
 	// Ignore all calls to subresources or resources other than pods.
 	if len(attributes.GetSubresource()) != 0 || attributes.GetResource().GroupResource() != api.Resource("pods") {
@@ -73,8 +115,8 @@ func (p *Plugin) Validate(ctx context.Context, attributes admission.Attributes,
 		//        podAntiAffinityTerms = append(podAntiAffinityTerms, affinity.PodAntiAffinity.RequiredDuringSchedulingRequiredDuringExecution...)
 		//}
 		for _, v := range podAntiAffinityTerms {
-			if v.TopologyKey != v1.LabelHostname {
-				return apierrors.NewForbidden(attributes.GetResource().GroupResource(), pod.Name, fmt.Errorf("affinity.PodAntiAffinity.RequiredDuringScheduling has TopologyKey %v but only key %v is allowed", v.TopologyKey, v1.LabelHostname))
+			if !p.allowedTopologyKeys.Has(v.TopologyKey) {
+				return apierrors.NewForbidden(attributes.GetResource().GroupResource(), pod.Name, fmt.Errorf("affinity.PodAntiAffinity.RequiredDuringScheduling has TopologyKey %v but only keys %v are allowed", v.TopologyKey, p.allowedTopologyKeys.List()))
 			}
 		}
 	}
@@ -18,6 +18,8 @@ package antiaffinity
 
 import (
 	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -31,7 +33,7 @@ import (
 // TODO: Add test case "invalid topologyKey in requiredDuringSchedulingRequiredDuringExecution then admission fails"
 // after RequiredDuringSchedulingRequiredDuringExecution is implemented.
 func TestInterPodAffinityAdmission(t *testing.T) {
-	handler := NewInterPodAntiAffinity()
+	handler := NewInterPodAntiAffinity(nil)
 	pod := api.Pod{
 		Spec: api.PodSpec{},
 	}
@@ -212,7 +214,7 @@ func TestInterPodAffinityAdmission(t *testing.T) {
 	}
 }
 func TestHandles(t *testing.T) {
-	handler := NewInterPodAntiAffinity()
+	handler := NewInterPodAntiAffinity(nil)
 	tests := map[admission.Operation]bool{
 		admission.Update:  true,
 		admission.Create:  true,
@@ -283,3 +285,76 @@ func TestOtherResources(t *testing.T) {
 		}
 	}
 }
+
+// ensures hard PodAntiAffinity topology keys beyond kubernetes.io/hostname are
+// accepted when configured, and that the default is preserved otherwise.
+func TestInterPodAntiAffinityAdmissionConfiguredKeys(t *testing.T) {
+	handler := NewInterPodAntiAffinity([]string{v1.LabelHostname, v1.LabelTopologyZone})
+	pod := api.Pod{
+		Spec: api.PodSpec{},
+	}
+	tests := []struct {
+		affinity      *api.Affinity
+		errorExpected bool
+	}{
+		{
+			affinity: &api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{TopologyKey: v1.LabelTopologyZone},
+					},
+				},
+			},
+			errorExpected: false,
+		},
+		{
+			affinity: &api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{TopologyKey: v1.LabelHostname},
+					},
+				},
+			},
+			errorExpected: false,
+		},
+		{
+			affinity: &api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{TopologyKey: "example.com/rack"},
+					},
+				},
+			},
+			errorExpected: true,
+		},
+	}
+	for _, test := range tests {
+		pod.Spec.Affinity = test.affinity
+		err := handler.Validate(context.TODO(), admission.NewAttributesRecord(&pod, nil, api.Kind("Pod").WithVersion("version"), "foo", "name", api.Resource("pods").WithVersion("version"), "", "ignored", nil, false, nil), nil)
+
+		if test.errorExpected && err == nil {
+			t.Errorf("Expected error for Anti Affinity %+v but did not get an error", test.affinity)
+		}
+
+		if !test.errorExpected && err != nil {
+			t.Errorf("Unexpected error %v for AntiAffinity %+v", err, test.affinity)
+		}
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	config := strings.NewReader(`
+podHardAntiAffinityTopologyKeys:
+- kubernetes.io/hostname
+- topology.kubernetes.io/zone
+`)
+	pluginConfig := readConfig(config)
+	expected := []string{"kubernetes.io/hostname", "topology.kubernetes.io/zone"}
+	if !reflect.DeepEqual(pluginConfig.PodHardAntiAffinityTopologyKeys, expected) {
+		t.Errorf("expected %v, got %v", expected, pluginConfig.PodHardAntiAffinityTopologyKeys)
+	}
+
+	if got := readConfig(nil); len(got.PodHardAntiAffinityTopologyKeys) != 0 {
+		t.Errorf("expected empty config for nil reader, got %v", got.PodHardAntiAffinityTopologyKeys)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package corev1
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// SupportedTaintEffects is the set of taint effects accepted by the API, in
+// the order they should be presented to users. Client-side tooling should
+// validate against this list so it agrees with server-side validation.
+var SupportedTaintEffects = []v1.TaintEffect{
+	v1.TaintEffectNoSchedule,
+	v1.TaintEffectPreferNoSchedule,
+	v1.TaintEffectNoExecute,
+}
+
+// ValidateTaintEffect returns an error when the given effect is not one of
+// the supported taint effects.
+func ValidateTaintEffect(effect v1.TaintEffect) error {
+	for _, supported := range SupportedTaintEffects {
+		if effect == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid taint effect: %v, unsupported taint effect", effect)
+}
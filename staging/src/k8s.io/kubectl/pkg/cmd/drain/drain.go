@@ -31,6 +31,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/kubectl/pkg/cmd/taint"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
@@ -45,6 +46,10 @@ type DrainCmdOptions struct {
 
 	Namespace string
 
+	// WithTaint is an optional taint spec (key[=value]:effect) applied when
+	// cordoning and removed when uncordoning.
+	WithTaint string
+
 	drainer   *drain.Helper
 	nodeInfos []*resource.Info
 
@@ -76,6 +81,7 @@ func NewCmdCordon(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cob
 		},
 	}
 	cmd.Flags().StringVarP(&o.drainer.Selector, "selector", "l", o.drainer.Selector, "Selector (label query) to filter on")
+	cmd.Flags().StringVar(&o.WithTaint, "with-taint", o.WithTaint, "Taint (key[=value]:effect) to apply to the node together with marking it unschedulable")
 	cmdutil.AddDryRunFlag(cmd)
 	return cmd
 }
@@ -105,6 +111,7 @@ func NewCmdUncordon(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *c
 		},
 	}
 	cmd.Flags().StringVarP(&o.drainer.Selector, "selector", "l", o.drainer.Selector, "Selector (label query) to filter on")
+	cmd.Flags().StringVar(&o.WithTaint, "with-taint", o.WithTaint, "Taint (key[=value]:effect) to remove from the node together with marking it schedulable")
 	cmdutil.AddDryRunFlag(cmd)
 	return cmd
 }
@@ -382,6 +389,18 @@ func (o *DrainCmdOptions) RunCordonOrUncordon(desired bool) error {
 		cordonOrUncordon = "un" + cordonOrUncordon
 	}
 
+	var cordonTaint *corev1.Taint
+	if o.WithTaint != "" {
+		t, err := taint.ParseTaint(o.WithTaint)
+		if err != nil {
+			return err
+		}
+		if len(t.Effect) == 0 {
+			return fmt.Errorf("invalid taint spec %q: effect must be specified", o.WithTaint)
+		}
+		cordonTaint = &t
+	}
+
 	for _, nodeInfo := range o.nodeInfos {
 
 		printError := func(err error) {
@@ -395,6 +414,9 @@ func (o *DrainCmdOptions) RunCordonOrUncordon(desired bool) error {
 				printError(err)
 				continue
 			}
+			if cordonTaint != nil {
+				c.SetTaint(*cordonTaint)
+			}
 
 			if updateRequired := c.UpdateIfRequired(desired); !updateRequired {
 				printObj, err := o.ToPrinter(already(desired))
@@ -17,25 +17,30 @@ limitations under the License.
 package taint
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/explain"
 	"k8s.io/kubectl/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/kubernetes"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/scheme"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -57,8 +62,11 @@ type TaintOptions struct {
 	selector       string
 	overwrite      bool
 	all            bool
+	force          bool
 	fieldManager   string
 
+	clientset kubernetes.Interface
+
 	ClientForMapping func(*meta.RESTMapping) (resource.RESTClient, error)
 
 	genericclioptions.IOStreams
@@ -109,7 +117,7 @@ func NewCmdTaint(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.
 		Short:                 i18n.T("Update the taints on one or more nodes"),
 		Long:                  fmt.Sprintf(taintLong, validation.DNS1123SubdomainMaxLength, validation.LabelValueMaxLength),
 		Example:               taintExample,
-		ValidArgsFunction:     util.SpecifiedResourceTypeAndNameCompletionFunc(f, validArgs),
+		ValidArgsFunction:     taintCompletionFunc(f, validArgs),
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(options.Complete(f, cmd, args))
 			cmdutil.CheckErr(options.Validate())
@@ -124,10 +132,63 @@ func NewCmdTaint(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.
 	cmd.Flags().StringVarP(&options.selector, "selector", "l", options.selector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	cmd.Flags().BoolVar(&options.overwrite, "overwrite", options.overwrite, "If true, allow taints to be overwritten, otherwise reject taint updates that overwrite existing taints.")
 	cmd.Flags().BoolVar(&options.all, "all", options.all, "Select all nodes in the cluster")
+	cmd.Flags().BoolVar(&options.force, "force", options.force, "If true, apply NoExecute taints even if they would evict running pods that do not tolerate them.")
 	cmdutil.AddFieldManagerFlagVar(cmd, &options.fieldManager, "kubectl-taint")
 	return cmd
 }
 
+// isTaintArg tells a taint change argument apart from a resource type or name.
+func isTaintArg(s string) bool {
+	return strings.Contains(s, "=") || strings.Contains(s, ":") || strings.HasSuffix(s, "-")
+}
+
+// taintCompletionFunc completes the resource type and node names the same way
+// SpecifiedResourceTypeAndNameCompletionFunc does and, once at least one node
+// name has been given, completes removal arguments of the form "<key>-" and
+// "<key>:<effect>-" built from the taints currently on the named nodes.
+func taintCompletionFunc(f cmdutil.Factory, allowedTypes []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	resourceCompletion := util.SpecifiedResourceTypeAndNameCompletionFunc(f, allowedTypes)
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) < 2 {
+			return resourceCompletion(cmd, args, toComplete)
+		}
+
+		comps := sets.NewString()
+		// Complete the effect once the user has typed "key:" or "key=value:".
+		if idx := strings.LastIndex(toComplete, ":"); idx >= 0 && !strings.HasSuffix(toComplete, "-") {
+			for _, effect := range corev1helpers.SupportedTaintEffects {
+				comp := toComplete[:idx+1] + string(effect)
+				if strings.HasPrefix(comp, toComplete) {
+					comps.Insert(comp)
+				}
+			}
+			return comps.List(), cobra.ShellCompDirectiveNoFileComp
+		}
+
+		clientSet, err := f.KubernetesClientSet()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		for _, arg := range args[1:] {
+			if isTaintArg(arg) {
+				continue
+			}
+			node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), arg, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+			for _, taint := range node.Spec.Taints {
+				for _, comp := range []string{taint.Key + "-", fmt.Sprintf("%s:%s-", taint.Key, taint.Effect)} {
+					if strings.HasPrefix(comp, toComplete) {
+						comps.Insert(comp)
+					}
+				}
+			}
+		}
+		return comps.List(), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 // Complete adapts from the command line args and factory to the data required.
 func (o *TaintOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) (err error) {
 	namespace, _, err := f.ToRawKubeConfigLoader().Namespace()
@@ -156,7 +217,7 @@ func (o *TaintOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []st
 	taintArgs := []string{}
 	metTaintArg := false
 	for _, s := range args {
-		isTaint := strings.Contains(s, "=") || strings.Contains(s, ":") || strings.HasSuffix(s, "-")
+		isTaint := isTaintArg(s)
 		switch {
 		case !metTaintArg && isTaint:
 			metTaintArg = true
@@ -203,6 +264,47 @@ func (o *TaintOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []st
 		Latest()
 
 	o.ClientForMapping = f.ClientForMapping
+	o.clientset, err = f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateNoExecuteImpact rejects NoExecute taints that would evict running
+// pods on the node that do not tolerate them, unless --force was given.
+func (o TaintOptions) validateNoExecuteImpact(nodeName string) error {
+	if o.force {
+		return nil
+	}
+	var noExecute []v1.Taint
+	for _, taint := range o.taintsToAdd {
+		if taint.Effect == v1.TaintEffectNoExecute {
+			noExecute = append(noExecute, taint)
+		}
+	}
+	if len(noExecute) == 0 {
+		return nil
+	}
+	pods, err := o.clientset.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("listing pods on node %s: %v", nodeName, err)
+	}
+	var affected []string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if _, isUntolerated := corev1helpers.FindMatchingUntoleratedTaint(noExecute, pod.Spec.Tolerations, nil); isUntolerated {
+			affected = append(affected, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	if len(affected) > 0 {
+		return fmt.Errorf("taint(s) would evict %d running pod(s) on node %s that do not tolerate them (%s); use --force to apply anyway",
+			len(affected), nodeName, strings.Join(affected, ", "))
+	}
 	return nil
 }
 
@@ -273,6 +375,9 @@ func (o TaintOptions) RunTaint() error {
 
 		obj := info.Object
 		name, namespace := info.Name, info.Namespace
+		if err := o.validateNoExecuteImpact(name); err != nil {
+			return err
+		}
 		oldData, err := json.Marshal(obj)
 		if err != nil {
 			return err
@@ -286,9 +391,8 @@ func (o TaintOptions) RunTaint() error {
 			return err
 		}
 		patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, obj)
-		createdPatch := err == nil
 		if err != nil {
-			klog.V(2).Infof("couldn't compute patch: %v", err)
+			return fmt.Errorf("couldn't compute patch: %v", err)
 		}
 
 		printer, err := o.ToPrinter(operation)
@@ -296,34 +400,31 @@ func (o TaintOptions) RunTaint() error {
 			return err
 		}
 		if o.DryRunStrategy == cmdutil.DryRunClient {
-			if createdPatch {
-				typedObj, err := scheme.Scheme.ConvertToVersion(info.Object, info.Mapping.GroupVersionKind.GroupVersion())
-				if err != nil {
-					return err
-				}
+			typedObj, err := scheme.Scheme.ConvertToVersion(info.Object, info.Mapping.GroupVersionKind.GroupVersion())
+			if err != nil {
+				return err
+			}
 
-				nodeObj, ok := typedObj.(*v1.Node)
-				if !ok {
-					return fmt.Errorf("unexpected type %T", typedObj)
-				}
+			nodeObj, ok := typedObj.(*v1.Node)
+			if !ok {
+				return fmt.Errorf("unexpected type %T", typedObj)
+			}
 
-				originalObjJS, err := json.Marshal(nodeObj)
-				if err != nil {
-					return err
-				}
+			originalObjJS, err := json.Marshal(nodeObj)
+			if err != nil {
+				return err
+			}
 
-				originalPatchedObjJS, err := strategicpatch.StrategicMergePatch(originalObjJS, patchBytes, nodeObj)
-				if err != nil {
-					return err
-				}
+			originalPatchedObjJS, err := strategicpatch.StrategicMergePatch(originalObjJS, patchBytes, nodeObj)
+			if err != nil {
+				return err
+			}
 
-				targetObj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, originalPatchedObjJS)
-				if err != nil {
-					return err
-				}
-				return printer.PrintObj(targetObj, o.Out)
+			targetObj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, originalPatchedObjJS)
+			if err != nil {
+				return err
 			}
-			return printer.PrintObj(obj, o.Out)
+			return printer.PrintObj(targetObj, o.Out)
 		}
 
 		mapping := info.ResourceMapping()
@@ -341,12 +442,7 @@ func (o TaintOptions) RunTaint() error {
 			WithFieldManager(o.fieldManager).
 			DryRun(o.DryRunStrategy == cmdutil.DryRunServer)
 
-		var outputObj runtime.Object
-		if createdPatch {
-			outputObj, err = helper.Patch(namespace, name, types.StrategicMergePatchType, patchBytes, nil)
-		} else {
-			outputObj, err = helper.Replace(namespace, name, false, obj)
-		}
+		outputObj, err := helper.Patch(namespace, name, types.StrategicMergePatchType, patchBytes, nil)
 		if err != nil {
 			return err
 		}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest/fake"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
@@ -281,20 +282,6 @@ func TestTaint(t *testing.T) {
 							t.Fatalf("%s: expected:\n%v\nsaw:\n%v\n", test.description, expectNewNode.Spec.Taints, newNode.Spec.Taints)
 						}
 						return &http.Response{StatusCode: http.StatusOK, Header: cmdtesting.DefaultHeader(), Body: cmdtesting.ObjBody(codec, newNode)}, nil
-					case m.isFor("PUT", "/nodes/node-name"):
-						tainted = true
-						data, err := ioutil.ReadAll(req.Body)
-						if err != nil {
-							t.Fatalf("%s: unexpected error: %v", test.description, err)
-						}
-						defer req.Body.Close()
-						if err := runtime.DecodeInto(codec, data, newNode); err != nil {
-							t.Fatalf("%s: unexpected error: %v", test.description, err)
-						}
-						if !equalTaints(expectNewNode.Spec.Taints, newNode.Spec.Taints) {
-							t.Fatalf("%s: expected:\n%v\nsaw:\n%v\n", test.description, expectNewNode.Spec.Taints, newNode.Spec.Taints)
-						}
-						return &http.Response{StatusCode: http.StatusOK, Header: cmdtesting.DefaultHeader(), Body: cmdtesting.ObjBody(codec, newNode)}, nil
 					default:
 						t.Fatalf("%s: unexpected request: %v %#v\n%#v", test.description, req.Method, req.URL, req)
 						return nil, nil
@@ -400,3 +387,75 @@ func (m *MyReq) isFor(method string, path string) bool {
 		req.URL.Path == strings.Join([]string{"/apis/extensions/v1beta1", path}, "") ||
 		req.URL.Path == strings.Join([]string{"/apis/batch/v1", path}, ""))
 }
+
+func TestValidateNoExecuteImpact(t *testing.T) {
+	intolerantPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns1"},
+		Spec:       corev1.PodSpec{NodeName: "node-name"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	tolerantPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ds", Namespace: "ns1"},
+		Spec: corev1.PodSpec{
+			NodeName: "node-name",
+			Tolerations: []corev1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	succeededPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "ns1"},
+		Spec:       corev1.PodSpec{NodeName: "node-name"},
+		Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+	noExecute := []corev1.Taint{{Key: "maintenance", Effect: corev1.TaintEffectNoExecute}}
+
+	tests := []struct {
+		description string
+		pods        []runtime.Object
+		taintsToAdd []corev1.Taint
+		force       bool
+		expectErr   bool
+	}{
+		{
+			description: "NoExecute taint with intolerant running pod is rejected",
+			pods:        []runtime.Object{intolerantPod},
+			taintsToAdd: noExecute,
+			expectErr:   true,
+		},
+		{
+			description: "NoExecute taint with intolerant running pod passes with --force",
+			pods:        []runtime.Object{intolerantPod},
+			taintsToAdd: noExecute,
+			force:       true,
+		},
+		{
+			description: "NoExecute taint with only tolerant or finished pods is accepted",
+			pods:        []runtime.Object{tolerantPod, succeededPod},
+			taintsToAdd: noExecute,
+		},
+		{
+			description: "NoSchedule taint never triggers the check",
+			pods:        []runtime.Object{intolerantPod},
+			taintsToAdd: []corev1.Taint{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			options := TaintOptions{
+				clientset:   clientsetfake.NewSimpleClientset(test.pods...),
+				taintsToAdd: test.taintsToAdd,
+				force:       test.force,
+			}
+			err := options.validateNoExecuteImpact("node-name")
+			if test.expectErr && err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			if !test.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
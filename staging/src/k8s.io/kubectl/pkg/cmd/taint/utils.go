@@ -25,6 +25,7 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 )
 
 // Exported taint constant strings
@@ -42,13 +43,13 @@ func parseTaints(spec []string) ([]corev1.Taint, []corev1.Taint, error) {
 
 	for _, taintSpec := range spec {
 		if strings.HasSuffix(taintSpec, "-") {
-			taintToRemove, err := parseTaint(strings.TrimSuffix(taintSpec, "-"))
+			taintToRemove, err := ParseTaint(strings.TrimSuffix(taintSpec, "-"))
 			if err != nil {
 				return nil, nil, err
 			}
 			taintsToRemove = append(taintsToRemove, corev1.Taint{Key: taintToRemove.Key, Effect: taintToRemove.Effect})
 		} else {
-			newTaint, err := parseTaint(taintSpec)
+			newTaint, err := ParseTaint(taintSpec)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -72,9 +73,9 @@ func parseTaints(spec []string) ([]corev1.Taint, []corev1.Taint, error) {
 	return taints, taintsToRemove, nil
 }
 
-// parseTaint parses a taint from a string, whose form must be either
+// ParseTaint parses a taint from a string, whose form must be either
 // '<key>=<value>:<effect>', '<key>:<effect>', or '<key>'.
-func parseTaint(st string) (corev1.Taint, error) {
+func ParseTaint(st string) (corev1.Taint, error) {
 	var taint corev1.Taint
 
 	var key string
@@ -118,11 +119,9 @@ func parseTaint(st string) (corev1.Taint, error) {
 }
 
 func validateTaintEffect(effect corev1.TaintEffect) error {
-	if effect != corev1.TaintEffectNoSchedule && effect != corev1.TaintEffectPreferNoSchedule && effect != corev1.TaintEffectNoExecute {
-		return fmt.Errorf("invalid taint effect: %v, unsupported taint effect", effect)
-	}
-
-	return nil
+	// Delegate to the shared helper so client-side validation agrees with
+	// the server's allowed set.
+	return corev1helpers.ValidateTaintEffect(effect)
 }
 
 // reorganizeTaints returns the updated set of taints, taking into account old taints that were not updated,
@@ -153,12 +152,25 @@ func deleteTaints(taintsToRemove []corev1.Taint, newTaints *[]corev1.Taint) ([]e
 			*newTaints, removed = deleteTaintsByKey(*newTaints, taintToRemove.Key)
 		}
 		if !removed {
-			allErrs = append(allErrs, fmt.Errorf("taint %q not found", taintToRemove.ToString()))
+			if existing := taintStrings(*newTaints); len(existing) > 0 {
+				allErrs = append(allErrs, fmt.Errorf("taint %q not found, existing taints are: %s", taintToRemove.ToString(), strings.Join(existing, ", ")))
+			} else {
+				allErrs = append(allErrs, fmt.Errorf("taint %q not found", taintToRemove.ToString()))
+			}
 		}
 	}
 	return allErrs, removed
 }
 
+// taintStrings formats taints for use in a suggestion list.
+func taintStrings(taints []corev1.Taint) []string {
+	strs := make([]string, 0, len(taints))
+	for i := range taints {
+		strs = append(strs, taints[i].ToString())
+	}
+	return strs
+}
+
 // addTaints adds the newTaints list to existing ones and updates the newTaints List.
 // TODO: This needs a rewrite to take only the new values instead of appended newTaints list to be consistent.
 func addTaints(oldTaints []corev1.Taint, newTaints *[]corev1.Taint) bool {
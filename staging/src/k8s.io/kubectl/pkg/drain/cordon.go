@@ -35,6 +35,9 @@ import (
 type CordonHelper struct {
 	node    *corev1.Node
 	desired bool
+	// taint, when set, is applied together with cordoning and removed on
+	// uncordoning.
+	taint *corev1.Taint
 }
 
 // NewCordonHelper returns a new CordonHelper
@@ -60,12 +63,35 @@ func NewCordonHelperFromRuntimeObject(nodeObject runtime.Object, scheme *runtime
 	return NewCordonHelper(node), nil
 }
 
-// UpdateIfRequired returns true if c.node.Spec.Unschedulable isn't already set,
-// or false when no change is needed
+// SetTaint makes the helper apply the given taint when cordoning and remove
+// it when uncordoning.
+func (c *CordonHelper) SetTaint(taint corev1.Taint) {
+	c.taint = &taint
+}
+
+// UpdateIfRequired returns true if c.node.Spec.Unschedulable isn't already set
+// (or a configured taint needs applying or removing), or false when no change
+// is needed
 func (c *CordonHelper) UpdateIfRequired(desired bool) bool {
 	c.desired = desired
 
-	return c.node.Spec.Unschedulable != c.desired
+	if c.node.Spec.Unschedulable != c.desired {
+		return true
+	}
+	if c.taint != nil && c.taintExists() != c.desired {
+		return true
+	}
+	return false
+}
+
+// taintExists returns whether the configured taint is present on the node.
+func (c *CordonHelper) taintExists() bool {
+	for i := range c.node.Spec.Taints {
+		if c.node.Spec.Taints[i].MatchTaint(c.taint) {
+			return true
+		}
+	}
+	return false
 }
 
 // PatchOrReplace uses given clientset to update the node status, either by patching or
@@ -87,6 +113,21 @@ func (c *CordonHelper) PatchOrReplaceWithContext(clientCtx context.Context, clie
 	}
 
 	c.node.Spec.Unschedulable = c.desired
+	if c.taint != nil {
+		if c.desired {
+			if !c.taintExists() {
+				c.node.Spec.Taints = append(c.node.Spec.Taints, *c.taint)
+			}
+		} else if c.taintExists() {
+			taints := make([]corev1.Taint, 0, len(c.node.Spec.Taints))
+			for _, taint := range c.node.Spec.Taints {
+				if !taint.MatchTaint(c.taint) {
+					taints = append(taints, taint)
+				}
+			}
+			c.node.Spec.Taints = taints
+		}
+	}
 
 	newData, err := json.Marshal(c.node)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/onsi/gomega"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
@@ -632,6 +633,9 @@ func AddOrUpdateTaintOnNode(c clientset.Interface, nodeName string, taint v1.Tai
 	// package from the core e2e framework.
 	err := addOrUpdateTaintOnNode(c, nodeName, &taint)
 	gomega.ExpectWithOffset(2, err).NotTo(gomega.HaveOccurred())
+	if err := WaitForNodeTaint(c, nodeName, &taint, poll, singleCallTimeout); err != nil {
+		e2elog.Failf("Failed adding taint " + taint.ToString() + " to the node " + nodeName)
+	}
 }
 
 // addOrUpdateTaintOnNode add taints to the node. If taint was added into node, it'll issue API calls
@@ -846,15 +850,69 @@ func deleteTaint(taints []v1.Taint, taintToDelete *v1.Taint) ([]v1.Taint, bool)
 
 func verifyThatTaintIsGone(c clientset.Interface, nodeName string, taint *v1.Taint) {
 	ginkgo.By("verifying the node doesn't have the taint " + taint.ToString())
-	nodeUpdated, err := c.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
-
-	// TODO use wrapper methods in expect.go after removing core e2e dependency on node
-	gomega.ExpectWithOffset(2, err).NotTo(gomega.HaveOccurred())
-	if taintExists(nodeUpdated.Spec.Taints, taint) {
+	if err := WaitForNodeTaintRemoval(c, nodeName, taint, poll, singleCallTimeout); err != nil {
 		e2elog.Failf("Failed removing taint " + taint.ToString() + " of the node " + nodeName)
 	}
 }
 
+// WaitForNodeTaint polls until the given taint is present on the node, or the
+// timeout is reached.
+func WaitForNodeTaint(c clientset.Interface, nodeName string, taint *v1.Taint, interval, timeout time.Duration) error {
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		node, err := c.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return taintExists(node.Spec.Taints, taint), nil
+	})
+}
+
+// WaitForNodeTaintRemoval polls until the given taint is absent from the
+// node, or the timeout is reached.
+func WaitForNodeTaintRemoval(c clientset.Interface, nodeName string, taint *v1.Taint, interval, timeout time.Duration) error {
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		node, err := c.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return !taintExists(node.Spec.Taints, taint), nil
+	})
+}
+
+// WaitForPodEvicted polls until the named pod has been evicted: deleted,
+// marked for deletion, or moved to a terminal phase.
+func WaitForPodEvicted(c clientset.Interface, namespace, name string, interval, timeout time.Duration) error {
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		pod, err := c.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return pod.DeletionTimestamp != nil || pod.Status.Phase == v1.PodFailed || pod.Status.Phase == v1.PodSucceeded, nil
+	})
+}
+
+// WaitForNoPodsOnNode polls until no non-terminal pods remain scheduled to
+// the given node, or the timeout is reached.
+func WaitForNoPodsOnNode(c clientset.Interface, nodeName string, interval, timeout time.Duration) error {
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		pods, err := c.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != v1.PodFailed && pod.Status.Phase != v1.PodSucceeded {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
 // taintExists checks if the given taint exists in list of taints. Returns true if exists false otherwise.
 func taintExists(taints []v1.Taint, taintToFind *v1.Taint) bool {
 	for _, taint := range taints {